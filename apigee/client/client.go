@@ -0,0 +1,95 @@
+// Package client is a small typed wrapper around the Apigee Management
+// API. It centralizes request construction, org substitution and error
+// decoding so individual Terraform resources don't each hand-roll
+// http.NewRequest/json.Marshal blocks.
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+const defaultBaseURL = "https://apigee.googleapis.com/v1"
+
+// Client is a thin typed wrapper around the Apigee Management API, scoped
+// to a single organization.
+type Client struct {
+	HTTP    *http.Client
+	Org     string
+	BaseURL string
+
+	DataCollectors    *DataCollectorsService
+	Environments      *EnvironmentsService
+	EnvironmentGroups *EnvironmentGroupsService
+	KeyValueMaps      *KeyValueMapsService
+	TargetServers     *TargetServersService
+	SharedFlows       *SharedFlowsService
+}
+
+// New returns a Client for org, with every resource service wired up.
+func New(httpClient *http.Client, org string) *Client {
+	c := &Client{HTTP: httpClient, Org: org, BaseURL: defaultBaseURL}
+	c.DataCollectors = &DataCollectorsService{c: c}
+	c.Environments = &EnvironmentsService{c: c}
+	c.EnvironmentGroups = &EnvironmentGroupsService{c: c}
+	c.KeyValueMaps = &KeyValueMapsService{c: c}
+	c.TargetServers = &TargetServersService{c: c}
+	c.SharedFlows = &SharedFlowsService{c: c}
+	return c
+}
+
+// orgURL builds a URL rooted at this client's organization, e.g.
+// orgURL("datacollectors/%s", name) -> ".../organizations/{org}/datacollectors/{name}".
+func (c *Client) orgURL(format string, a ...interface{}) string {
+	return fmt.Sprintf(c.BaseURL+"/organizations/%s/"+format, append([]interface{}{c.Org}, a...)...)
+}
+
+// do issues an HTTP request against the Apigee API, JSON-encoding body (if
+// non-nil) and JSON-decoding the response into out (if non-nil). A non-2xx
+// response is decoded into an *APIError instead of being surfaced as a bare
+// status line.
+func (c *Client) do(ctx context.Context, method, url string, body, out interface{}) ([]byte, error) {
+	var reqBody io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal request body: %w", err)
+		}
+		reqBody = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, url, reqBody)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build %s request to %s: %w", method, url, err)
+	}
+	if reqBody != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.HTTP.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("request to %s failed: %w", url, err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response from %s: %w", url, err)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return respBody, decodeError(resp.StatusCode, respBody)
+	}
+
+	if out != nil && len(respBody) > 0 {
+		if err := json.Unmarshal(respBody, out); err != nil {
+			return respBody, fmt.Errorf("failed to decode response from %s: %w", url, err)
+		}
+	}
+
+	return respBody, nil
+}
@@ -0,0 +1,47 @@
+package client
+
+import "context"
+
+// DataCollector maps to organizations/{org}/datacollectors/{name}.
+type DataCollector struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Type        string `json:"type"`
+}
+
+// DataCollectorsService manages Apigee data collectors. Create and Update
+// return the raw response body alongside the decoded DataCollector, and
+// Delete returns the raw body alone, because the API answers some of these calls with a
+// google.longrunning.Operation instead of the final resource; callers wait
+// on it via apigee.IsOperation/Waiter.
+type DataCollectorsService struct{ c *Client }
+
+func (s *DataCollectorsService) Create(ctx context.Context, dc *DataCollector) (*DataCollector, []byte, error) {
+	out := &DataCollector{}
+	body, err := s.c.do(ctx, "POST", s.c.orgURL("datacollectors"), dc, out)
+	return out, body, err
+}
+
+func (s *DataCollectorsService) Get(ctx context.Context, name string) (*DataCollector, error) {
+	out := &DataCollector{}
+	_, err := s.c.do(ctx, "GET", s.c.orgURL("datacollectors/%s", name), nil, out)
+	return out, err
+}
+
+func (s *DataCollectorsService) Update(ctx context.Context, dc *DataCollector) (*DataCollector, []byte, error) {
+	out := &DataCollector{}
+	body, err := s.c.do(ctx, "PUT", s.c.orgURL("datacollectors/%s", dc.Name), dc, out)
+	return out, body, err
+}
+
+func (s *DataCollectorsService) Delete(ctx context.Context, name string) ([]byte, error) {
+	return s.c.do(ctx, "DELETE", s.c.orgURL("datacollectors/%s", name), nil, nil)
+}
+
+func (s *DataCollectorsService) List(ctx context.Context) ([]*DataCollector, error) {
+	var out struct {
+		DataCollectors []*DataCollector `json:"dataCollectors"`
+	}
+	_, err := s.c.do(ctx, "GET", s.c.orgURL("datacollectors"), nil, &out)
+	return out.DataCollectors, err
+}
@@ -0,0 +1,38 @@
+package client
+
+import "context"
+
+// EnvironmentGroup maps to organizations/{org}/envgroups/{name}.
+type EnvironmentGroup struct {
+	Name      string   `json:"name"`
+	Hostnames []string `json:"hostnames,omitempty"`
+}
+
+// EnvironmentGroupsService manages Apigee environment groups. Create and
+// Update return the raw response body alongside the decoded
+// EnvironmentGroup, and Delete returns the raw body alone, because the API answers some of these calls with a
+// google.longrunning.Operation instead of the final resource; callers wait
+// on it via apigee.IsOperation/Waiter.
+type EnvironmentGroupsService struct{ c *Client }
+
+func (s *EnvironmentGroupsService) Create(ctx context.Context, group *EnvironmentGroup) (*EnvironmentGroup, []byte, error) {
+	out := &EnvironmentGroup{}
+	body, err := s.c.do(ctx, "POST", s.c.orgURL("envgroups"), group, out)
+	return out, body, err
+}
+
+func (s *EnvironmentGroupsService) Get(ctx context.Context, name string) (*EnvironmentGroup, error) {
+	out := &EnvironmentGroup{}
+	_, err := s.c.do(ctx, "GET", s.c.orgURL("envgroups/%s", name), nil, out)
+	return out, err
+}
+
+func (s *EnvironmentGroupsService) Update(ctx context.Context, group *EnvironmentGroup) (*EnvironmentGroup, []byte, error) {
+	out := &EnvironmentGroup{}
+	body, err := s.c.do(ctx, "PUT", s.c.orgURL("envgroups/%s", group.Name), group, out)
+	return out, body, err
+}
+
+func (s *EnvironmentGroupsService) Delete(ctx context.Context, name string) ([]byte, error) {
+	return s.c.do(ctx, "DELETE", s.c.orgURL("envgroups/%s", name), nil, nil)
+}
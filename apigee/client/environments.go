@@ -0,0 +1,39 @@
+package client
+
+import "context"
+
+// Environment maps to organizations/{org}/environments/{name}.
+type Environment struct {
+	Name        string `json:"name"`
+	DisplayName string `json:"displayName,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// EnvironmentsService manages Apigee environments. Create and Update return
+// the raw response body alongside the decoded Environment, and Delete
+// returns the raw body alone, because the API answers some of these calls with a
+// google.longrunning.Operation instead of the final resource; callers wait
+// on it via apigee.IsOperation/Waiter.
+type EnvironmentsService struct{ c *Client }
+
+func (s *EnvironmentsService) Create(ctx context.Context, env *Environment) (*Environment, []byte, error) {
+	out := &Environment{}
+	body, err := s.c.do(ctx, "POST", s.c.orgURL("environments"), env, out)
+	return out, body, err
+}
+
+func (s *EnvironmentsService) Get(ctx context.Context, name string) (*Environment, error) {
+	out := &Environment{}
+	_, err := s.c.do(ctx, "GET", s.c.orgURL("environments/%s", name), nil, out)
+	return out, err
+}
+
+func (s *EnvironmentsService) Update(ctx context.Context, env *Environment) (*Environment, []byte, error) {
+	out := &Environment{}
+	body, err := s.c.do(ctx, "PUT", s.c.orgURL("environments/%s", env.Name), env, out)
+	return out, body, err
+}
+
+func (s *EnvironmentsService) Delete(ctx context.Context, name string) ([]byte, error) {
+	return s.c.do(ctx, "DELETE", s.c.orgURL("environments/%s", name), nil, nil)
+}
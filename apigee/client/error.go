@@ -0,0 +1,42 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// APIError is a structured decoding of the Google API error envelope
+// ({"error": {"code": ..., "status": ..., "message": ...}}) Apigee returns
+// on failure, so callers can build diagnostics from Code/Status instead of
+// just a raw HTTP status line.
+type APIError struct {
+	HTTPStatusCode int    `json:"-"`
+	Code           int    `json:"code"`
+	Status         string `json:"status"`
+	Message        string `json:"message"`
+}
+
+func (e *APIError) Error() string {
+	if e.Message != "" {
+		return fmt.Sprintf("apigee API error (%s): %s", e.Status, e.Message)
+	}
+	return fmt.Sprintf("apigee API error: HTTP %d", e.HTTPStatusCode)
+}
+
+// NotFound reports whether err represents a 404 from the API.
+func NotFound(err error) bool {
+	apiErr, ok := err.(*APIError)
+	return ok && apiErr.HTTPStatusCode == http.StatusNotFound
+}
+
+func decodeError(httpStatus int, body []byte) error {
+	var envelope struct {
+		Error APIError `json:"error"`
+	}
+	if err := json.Unmarshal(body, &envelope); err != nil || envelope.Error.Message == "" {
+		return &APIError{HTTPStatusCode: httpStatus, Message: string(body)}
+	}
+	envelope.Error.HTTPStatusCode = httpStatus
+	return &envelope.Error
+}
@@ -0,0 +1,35 @@
+package client
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestDecodeErrorNotFound(t *testing.T) {
+	body := []byte(`{"error":{"code":404,"status":"NOT_FOUND","message":"DataCollector not found"}}`)
+
+	err := decodeError(http.StatusNotFound, body)
+
+	if !NotFound(err) {
+		t.Fatalf("NotFound(%v) = false, want true", err)
+	}
+
+	apiErr, ok := err.(*APIError)
+	if !ok {
+		t.Fatalf("decodeError returned %T, want *APIError", err)
+	}
+	if apiErr.Message != "DataCollector not found" {
+		t.Fatalf("apiErr.Message = %q, want %q", apiErr.Message, "DataCollector not found")
+	}
+}
+
+func TestDecodeErrorNonJSONBody(t *testing.T) {
+	err := decodeError(http.StatusInternalServerError, []byte("upstream timeout"))
+
+	if NotFound(err) {
+		t.Fatalf("NotFound(%v) = true, want false", err)
+	}
+	if err.Error() == "" {
+		t.Fatal("expected a non-empty error message")
+	}
+}
@@ -0,0 +1,35 @@
+package client
+
+import "context"
+
+// KeyValueMapEntry is a single key/value pair within a KeyValueMap.
+type KeyValueMapEntry struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// KeyValueMap maps to organizations/{org}/keyvaluemaps/{name}.
+type KeyValueMap struct {
+	Name    string             `json:"name"`
+	Entries []KeyValueMapEntry `json:"entries,omitempty"`
+}
+
+// KeyValueMapsService manages org-scoped Apigee key value maps.
+type KeyValueMapsService struct{ c *Client }
+
+func (s *KeyValueMapsService) Create(ctx context.Context, kvm *KeyValueMap) (*KeyValueMap, error) {
+	out := &KeyValueMap{}
+	_, err := s.c.do(ctx, "POST", s.c.orgURL("keyvaluemaps"), kvm, out)
+	return out, err
+}
+
+func (s *KeyValueMapsService) Get(ctx context.Context, name string) (*KeyValueMap, error) {
+	out := &KeyValueMap{}
+	_, err := s.c.do(ctx, "GET", s.c.orgURL("keyvaluemaps/%s", name), nil, out)
+	return out, err
+}
+
+func (s *KeyValueMapsService) Delete(ctx context.Context, name string) error {
+	_, err := s.c.do(ctx, "DELETE", s.c.orgURL("keyvaluemaps/%s", name), nil, nil)
+	return err
+}
@@ -0,0 +1,31 @@
+package client
+
+import "context"
+
+// SharedFlow maps to organizations/{org}/sharedflows/{name}.
+type SharedFlow struct {
+	Name           string `json:"name"`
+	LatestRevision string `json:"latestRevisionId,omitempty"`
+}
+
+// SharedFlowsService manages Apigee shared flows.
+type SharedFlowsService struct{ c *Client }
+
+func (s *SharedFlowsService) Get(ctx context.Context, name string) (*SharedFlow, error) {
+	out := &SharedFlow{}
+	_, err := s.c.do(ctx, "GET", s.c.orgURL("sharedflows/%s", name), nil, out)
+	return out, err
+}
+
+func (s *SharedFlowsService) Delete(ctx context.Context, name string) error {
+	_, err := s.c.do(ctx, "DELETE", s.c.orgURL("sharedflows/%s", name), nil, nil)
+	return err
+}
+
+func (s *SharedFlowsService) List(ctx context.Context) ([]string, error) {
+	var out struct {
+		SharedFlows []string `json:"sharedFlows"`
+	}
+	_, err := s.c.do(ctx, "GET", s.c.orgURL("sharedflows"), nil, &out)
+	return out.SharedFlows, err
+}
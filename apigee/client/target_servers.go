@@ -0,0 +1,43 @@
+package client
+
+import "context"
+
+// TargetServer maps to
+// organizations/{org}/environments/{env}/targetservers/{name}.
+type TargetServer struct {
+	Name      string `json:"name"`
+	Host      string `json:"host"`
+	Port      int    `json:"port"`
+	IsEnabled bool   `json:"isEnabled"`
+}
+
+// TargetServersService manages environment-scoped Apigee target servers.
+type TargetServersService struct{ c *Client }
+
+func (s *TargetServersService) envURL(env string, format string, a ...interface{}) string {
+	args := append([]interface{}{env}, a...)
+	return s.c.orgURL("environments/%s/targetservers"+format, args...)
+}
+
+func (s *TargetServersService) Create(ctx context.Context, env string, ts *TargetServer) (*TargetServer, error) {
+	out := &TargetServer{}
+	_, err := s.c.do(ctx, "POST", s.envURL(env, ""), ts, out)
+	return out, err
+}
+
+func (s *TargetServersService) Get(ctx context.Context, env, name string) (*TargetServer, error) {
+	out := &TargetServer{}
+	_, err := s.c.do(ctx, "GET", s.envURL(env, "/%s", name), nil, out)
+	return out, err
+}
+
+func (s *TargetServersService) Update(ctx context.Context, env string, ts *TargetServer) (*TargetServer, error) {
+	out := &TargetServer{}
+	_, err := s.c.do(ctx, "PUT", s.envURL(env, "/%s", ts.Name), ts, out)
+	return out, err
+}
+
+func (s *TargetServersService) Delete(ctx context.Context, env, name string) error {
+	_, err := s.c.do(ctx, "DELETE", s.envURL(env, "/%s", name), nil, nil)
+	return err
+}
@@ -0,0 +1,126 @@
+// Package apigee holds helpers shared across the provider's Apigee
+// resources that don't belong to any single Terraform resource.
+package apigee
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	operationPollDelay    = 10 * time.Second
+	operationPollMinDelay = 2 * time.Second
+)
+
+// operation mirrors the subset of google.longrunning.Operation fields the
+// waiter cares about.
+type operation struct {
+	Name     string          `json:"name"`
+	Done     bool            `json:"done"`
+	Error    *operationError `json:"error"`
+	Response json.RawMessage `json:"response"`
+}
+
+type operationError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *operationError) Error() string {
+	return fmt.Sprintf("operation failed with code %d: %s", e.Code, e.Message)
+}
+
+// Waiter polls an Apigee long running operation until it completes or the
+// caller-supplied timeout elapses. It's modeled on the compute package's
+// OperationWaiter: construct one per operation, then call Wait.
+type Waiter struct {
+	Client  *http.Client
+	Org     string
+	Name    string
+	BaseURL string
+}
+
+// IsOperation reports whether a raw Apigee API response body describes a
+// google.longrunning.Operation rather than a finished resource, i.e. it has
+// a "name" field shaped like "organizations/{org}/operations/{id}".
+func IsOperation(body []byte) (name string, ok bool) {
+	var op operation
+	if err := json.Unmarshal(body, &op); err != nil {
+		return "", false
+	}
+	if op.Name == "" || !strings.Contains(op.Name, "/operations/") {
+		return "", false
+	}
+	return op.Name, true
+}
+
+// Wait polls the operation, starting 2s apart and doubling the delay up to
+// 10s, until it reports done, the context is cancelled, or timeout elapses. If response is non-nil, the
+// operation's "response" payload is unmarshalled into it once done.
+func (w *Waiter) Wait(ctx context.Context, timeout time.Duration, response interface{}) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	delay := operationPollMinDelay
+	url := fmt.Sprintf("%s/%s", w.BaseURL, w.Name)
+
+	for {
+		op, err := w.poll(ctx, url)
+		if err != nil {
+			return err
+		}
+
+		if op.Done {
+			if op.Error != nil {
+				return op.Error
+			}
+			if response != nil && len(op.Response) > 0 {
+				if err := json.Unmarshal(op.Response, response); err != nil {
+					return fmt.Errorf("failed to unmarshal operation response: %w", err)
+				}
+			}
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timed out waiting for operation %s: %w", w.Name, ctx.Err())
+		case <-time.After(delay):
+		}
+
+		if delay < operationPollDelay {
+			delay *= 2
+			if delay > operationPollDelay {
+				delay = operationPollDelay
+			}
+		}
+	}
+}
+
+func (w *Waiter) poll(ctx context.Context, url string) (*operation, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build operation request: %w", err)
+	}
+
+	resp, err := w.Client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to poll operation %s: %w", w.Name, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to poll operation %s: %s", w.Name, resp.Status)
+	}
+
+	var op operation
+	if err := json.NewDecoder(resp.Body).Decode(&op); err != nil {
+		return nil, fmt.Errorf("failed to decode operation %s: %w", w.Name, err)
+	}
+
+	return &op, nil
+}
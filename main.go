@@ -1,12 +1,20 @@
 package main
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/moodys-ma-external/kyc-apigee/tf-provider/dc-apigee/provider"
 )
 
 func main() {
-	plugin.Serve(&plugin.ServeOpts{
-		ProviderFunc: provider.Provider,
+	ctx := context.Background()
+
+	err := providerserver.Serve(ctx, provider.NewFrameworkProvider, providerserver.ServeOpts{
+		Address: "registry.terraform.io/moodys-ma-external/dc-apigee",
 	})
+	if err != nil {
+		log.Fatal(err)
+	}
 }
@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/google"
+	"google.golang.org/api/impersonate"
+	"google.golang.org/api/option"
+)
+
+var apigeeScopes = []string{"https://www.googleapis.com/auth/cloud-platform"}
+
+// newTokenSource builds an oauth2.TokenSource from the provider's auth
+// attributes. Exactly one of google_credentials or access_token may be set;
+// if neither is set Application Default Credentials are used. Those base
+// credentials are optionally wrapped to impersonate a service account, and
+// the result is always wrapped in oauth2.ReuseTokenSource so callers get
+// automatic refresh without re-reading credentials on every request.
+func newTokenSource(ctx context.Context, model ProviderModel) (oauth2.TokenSource, error) {
+	if !model.GoogleCredentials.IsNull() && model.GoogleCredentials.ValueString() != "" &&
+		!model.AccessToken.IsNull() && model.AccessToken.ValueString() != "" {
+		return nil, fmt.Errorf("google_credentials and access_token are mutually exclusive")
+	}
+
+	var ts oauth2.TokenSource
+
+	switch {
+	case !model.AccessToken.IsNull() && model.AccessToken.ValueString() != "":
+		ts = oauth2.StaticTokenSource(&oauth2.Token{AccessToken: model.AccessToken.ValueString()})
+	case !model.GoogleCredentials.IsNull() && model.GoogleCredentials.ValueString() != "":
+		contents, err := pathOrContents(model.GoogleCredentials.ValueString())
+		if err != nil {
+			return nil, fmt.Errorf("failed to read google_credentials: %w", err)
+		}
+		creds, err := google.CredentialsFromJSON(ctx, []byte(contents), apigeeScopes...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse google_credentials: %w", err)
+		}
+		ts = creds.TokenSource
+	default:
+		creds, err := google.FindDefaultCredentials(ctx, apigeeScopes...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find application default credentials: %w", err)
+		}
+		ts = creds.TokenSource
+	}
+
+	if !model.ImpersonateServiceAccount.IsNull() && model.ImpersonateServiceAccount.ValueString() != "" {
+		impersonated, err := impersonate.CredentialsTokenSource(ctx, impersonate.CredentialsConfig{
+			TargetPrincipal: model.ImpersonateServiceAccount.ValueString(),
+			Scopes:          apigeeScopes,
+			Delegates:       impersonateDelegates(model),
+		}, option.WithTokenSource(ts))
+		if err != nil {
+			return nil, fmt.Errorf("failed to impersonate %s: %w", model.ImpersonateServiceAccount.ValueString(), err)
+		}
+		ts = impersonated
+	}
+
+	return oauth2.ReuseTokenSource(nil, ts), nil
+}
+
+func impersonateDelegates(model ProviderModel) []string {
+	if model.ImpersonateServiceAccountDelegates == nil {
+		return nil
+	}
+	delegates := make([]string, 0, len(model.ImpersonateServiceAccountDelegates))
+	for _, d := range model.ImpersonateServiceAccountDelegates {
+		delegates = append(delegates, d.ValueString())
+	}
+	return delegates
+}
+
+// pathOrContents mirrors the helper used by the Google provider: if a file
+// exists at poc (with a leading "~" expanded to the home directory), that
+// file's contents are returned; otherwise poc is returned verbatim.
+func pathOrContents(poc string) (string, error) {
+	if len(poc) == 0 {
+		return poc, nil
+	}
+
+	path := poc
+	if path[0] == '~' {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return path, err
+		}
+		path = home + strings.TrimPrefix(path, "~")
+	}
+
+	if _, err := os.Stat(path); err == nil {
+		contents, err := os.ReadFile(path)
+		if err != nil {
+			return string(contents), err
+		}
+		return string(contents), nil
+	}
+
+	return poc, nil
+}
@@ -0,0 +1,193 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/oauth2"
+
+	"github.com/moodys-ma-external/kyc-apigee/tf-provider/dc-apigee/apigee/client"
+)
+
+// Ensure dcApigeeProvider satisfies the provider.Provider interface.
+var _ provider.Provider = &dcApigeeProvider{}
+
+// dcApigeeProvider is the terraform-plugin-framework implementation of the
+// dc-apigee provider and the only provider implementation main serves. The
+// SDKv2 provider it replaces is not served alongside it, so SDKv2 resources
+// are not available through this binary.
+type dcApigeeProvider struct{}
+
+// NewFrameworkProvider returns a new instance of the framework provider.
+func NewFrameworkProvider() provider.Provider {
+	return &dcApigeeProvider{}
+}
+
+// ProviderModel describes the provider-level configuration block.
+type ProviderModel struct {
+	DcNames                            []types.String `tfsdk:"dc_names"`
+	OrgName                            types.String   `tfsdk:"org_name"`
+	GoogleCredentials                  types.String   `tfsdk:"google_credentials"`
+	AccessToken                        types.String   `tfsdk:"access_token"`
+	ImpersonateServiceAccount          types.String   `tfsdk:"impersonate_service_account"`
+	ImpersonateServiceAccountDelegates []types.String `tfsdk:"impersonate_service_account_delegates"`
+	Endpoint                           types.String   `tfsdk:"endpoint"`
+	RequestTimeout                     types.String   `tfsdk:"request_timeout"`
+	UserProjectOverride                types.Bool     `tfsdk:"user_project_override"`
+}
+
+// frameworkConfig is the resolved, ready-to-use configuration handed to
+// every framework resource's Configure method. client is built from a
+// refreshing oauth2.TokenSource, so long-running applies don't fail when a
+// token expires mid-apply.
+type frameworkConfig struct {
+	client  *http.Client
+	org     string
+	baseURL string
+	apigee  *client.Client
+	dcNames []string
+}
+
+// userProjectOverrideTransport sets the X-Goog-User-Project header on every
+// request so quota is billed to the given project instead of whatever
+// project the credentials themselves belong to.
+type userProjectOverrideTransport struct {
+	base    http.RoundTripper
+	project string
+}
+
+func (t *userProjectOverrideTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.Header.Set("X-Goog-User-Project", t.project)
+
+	base := t.base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return base.RoundTrip(req)
+}
+
+func (p *dcApigeeProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
+	resp.TypeName = "dc"
+}
+
+func (p *dcApigeeProvider) Schema(ctx context.Context, req provider.SchemaRequest, resp *provider.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"dc_names": schema.ListAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Description: "The data collector names",
+			},
+			"org_name": schema.StringAttribute{
+				Required:    true,
+				Description: "The organization name",
+			},
+			"google_credentials": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Google credentials JSON (raw or a path to a file containing it)",
+			},
+			"access_token": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "A short-lived OAuth access token, mutually exclusive with google_credentials",
+			},
+			"impersonate_service_account": schema.StringAttribute{
+				Optional:    true,
+				Description: "The service account to impersonate for all API calls",
+			},
+			"impersonate_service_account_delegates": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Delegate chain for impersonate_service_account",
+			},
+			"endpoint": schema.StringAttribute{
+				Optional:    true,
+				Description: "The Apigee API base URL, defaults to https://apigee.googleapis.com/v1",
+			},
+			"request_timeout": schema.StringAttribute{
+				Optional:    true,
+				Description: "Default timeout for API requests, e.g. \"30s\"",
+			},
+			"user_project_override": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Bill quota to org_name's host project (sent as the X-Goog-User-Project header) instead of the credentials' project",
+			},
+		},
+	}
+}
+
+func (p *dcApigeeProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	var model ProviderModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tokenSource, err := newTokenSource(ctx, model)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to configure authentication", err.Error())
+		return
+	}
+
+	httpClient := oauth2.NewClient(ctx, tokenSource)
+
+	if !model.RequestTimeout.IsNull() && model.RequestTimeout.ValueString() != "" {
+		timeout, err := time.ParseDuration(model.RequestTimeout.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("request_timeout"), "Invalid request_timeout", err.Error())
+			return
+		}
+		httpClient.Timeout = timeout
+	}
+
+	if model.UserProjectOverride.ValueBool() {
+		httpClient.Transport = &userProjectOverrideTransport{
+			base:    httpClient.Transport,
+			project: model.OrgName.ValueString(),
+		}
+	}
+
+	cfg := &frameworkConfig{
+		client:  httpClient,
+		org:     model.OrgName.ValueString(),
+		baseURL: "https://apigee.googleapis.com/v1",
+		dcNames: toStringSlice(model.DcNames),
+	}
+	if !model.Endpoint.IsNull() && model.Endpoint.ValueString() != "" {
+		cfg.baseURL = model.Endpoint.ValueString()
+	}
+
+	cfg.apigee = client.New(httpClient, cfg.org)
+	if cfg.baseURL != "" {
+		cfg.apigee.BaseURL = cfg.baseURL
+	}
+
+	resp.ResourceData = cfg
+	resp.DataSourceData = cfg
+}
+
+func (p *dcApigeeProvider) Resources(ctx context.Context) []func() resource.Resource {
+	return []func() resource.Resource{
+		NewDataCollectorResource,
+		NewDataCollectorsResource,
+		NewEnvironmentResource,
+		NewEnvironmentGroupResource,
+		NewKeyValueMapResource,
+		NewTargetServerResource,
+		NewSharedFlowResource,
+	}
+}
+
+func (p *dcApigeeProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
+	return []func() datasource.DataSource{}
+}
+
@@ -0,0 +1,26 @@
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/moodys-ma-external/kyc-apigee/tf-provider/dc-apigee/apigee"
+)
+
+// defaultOperationTimeout bounds how long a resource waits for an Apigee
+// long-running operation to finish when its timeouts block doesn't set a
+// value for that operation.
+const defaultOperationTimeout = 20 * time.Minute
+
+// waitIfOperation checks whether body describes a google.longrunning.Operation
+// (several Apigee admin endpoints return one instead of the final resource)
+// and, if so, blocks until it completes.
+func waitIfOperation(ctx context.Context, cfg *frameworkConfig, body []byte, timeout time.Duration, response interface{}) error {
+	name, ok := apigee.IsOperation(body)
+	if !ok {
+		return nil
+	}
+
+	waiter := &apigee.Waiter{Client: cfg.client, Org: cfg.org, Name: name, BaseURL: cfg.apigee.BaseURL}
+	return waiter.Wait(ctx, timeout, response)
+}
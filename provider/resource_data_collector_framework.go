@@ -0,0 +1,231 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/moodys-ma-external/kyc-apigee/tf-provider/dc-apigee/apigee/client"
+)
+
+const defaultDataCollectorTimeout = 20 * time.Minute
+
+// Ensure dataCollectorResource satisfies the resource.Resource interface.
+var (
+	_ resource.Resource                = &dataCollectorResource{}
+	_ resource.ResourceWithConfigure   = &dataCollectorResource{}
+	_ resource.ResourceWithImportState = &dataCollectorResource{}
+)
+
+// NewDataCollectorResource returns a new instance of the dc_collector
+// framework resource.
+func NewDataCollectorResource() resource.Resource {
+	return &dataCollectorResource{}
+}
+
+type dataCollectorResource struct {
+	cfg *frameworkConfig
+}
+
+// dataCollectorModel maps the dc_collector schema onto Go types.
+type dataCollectorModel struct {
+	Name        types.String   `tfsdk:"name"`
+	Description types.String   `tfsdk:"description"`
+	Type        types.String   `tfsdk:"type"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *dataCollectorResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_collector"
+}
+
+func (r *dataCollectorResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Optional: true,
+			},
+			"type": schema.StringAttribute{
+				Required: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *dataCollectorResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	cfg, ok := req.ProviderData.(*frameworkConfig)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected resource configure type", fmt.Sprintf("expected *frameworkConfig, got: %T", req.ProviderData))
+		return
+	}
+	r.cfg = cfg
+}
+
+func (r *dataCollectorResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan dataCollectorModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, body, err := r.cfg.apigee.DataCollectors.Create(ctx, &client.DataCollector{
+		Name:        plan.Name.ValueString(),
+		Description: plan.Description.ValueString(),
+		Type:        plan.Type.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create data collector", err.Error())
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultDataCollectorTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := waitIfOperation(ctx, r.cfg, body, createTimeout, nil); err != nil {
+		resp.Diagnostics.AddError("Failed waiting for data collector creation", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *dataCollectorResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state dataCollectorModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dc, err := r.cfg.apigee.DataCollectors.Get(ctx, state.Name.ValueString())
+	if err != nil {
+		if client.NotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Failed to read data collector", err.Error())
+		return
+	}
+
+	state.Name = types.StringValue(dc.Name)
+	state.Description = types.StringValue(dc.Description)
+	state.Type = types.StringValue(dc.Type)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *dataCollectorResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan dataCollectorModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, body, err := r.cfg.apigee.DataCollectors.Update(ctx, &client.DataCollector{
+		Name:        plan.Name.ValueString(),
+		Description: plan.Description.ValueString(),
+		Type:        plan.Type.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update data collector", err.Error())
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultDataCollectorTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := waitIfOperation(ctx, r.cfg, body, updateTimeout, nil); err != nil {
+		resp.Diagnostics.AddError("Failed waiting for data collector update", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *dataCollectorResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state dataCollectorModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, err := r.cfg.apigee.DataCollectors.Delete(ctx, state.Name.ValueString())
+	if err != nil && !client.NotFound(err) {
+		resp.Diagnostics.AddError("Failed to delete data collector", err.Error())
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDataCollectorTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := waitIfOperation(ctx, r.cfg, body, deleteTimeout, nil); err != nil {
+		resp.Diagnostics.AddError("Failed waiting for data collector deletion", err.Error())
+		return
+	}
+}
+
+// ImportState accepts either the fully qualified resource name
+// ("organizations/{org}/datacollectors/{name}") or just "{name}", in which
+// case the name is taken as-is and reconciled against the provider's org on
+// the next Read.
+func (r *dataCollectorResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	name, err := parseDataCollectorImportID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
+}
+
+// parseDataCollectorImportID extracts the data collector name from either
+// "organizations/{org}/datacollectors/{name}" or a bare "{name}". The org
+// segment, if present, isn't validated against the provider's configured
+// org_name since the API itself will 404 on a mismatch at Read time.
+func parseDataCollectorImportID(id string) (string, error) {
+	if id == "" {
+		return "", fmt.Errorf("import ID must not be empty")
+	}
+
+	if !strings.Contains(id, "/") {
+		return id, nil
+	}
+
+	parts := strings.Split(id, "/")
+	if len(parts) != 4 || parts[0] != "organizations" || parts[2] != "datacollectors" {
+		return "", fmt.Errorf("unexpected import ID %q, expected organizations/{org}/datacollectors/{name} or {name}", id)
+	}
+
+	return parts[3], nil
+}
@@ -0,0 +1,106 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/moodys-ma-external/kyc-apigee/tf-provider/dc-apigee/apigee/client"
+)
+
+func TestParseDataCollectorImportID(t *testing.T) {
+	cases := []struct {
+		name    string
+		id      string
+		want    string
+		wantErr bool
+	}{
+		{name: "bare name", id: "my-collector", want: "my-collector"},
+		{name: "fully qualified", id: "organizations/my-org/datacollectors/my-collector", want: "my-collector"},
+		{name: "wrong segment count", id: "organizations/my-org/datacollectors", wantErr: true},
+		{name: "wrong resource type", id: "organizations/my-org/environments/my-collector", wantErr: true},
+		{name: "empty", id: "", wantErr: true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := parseDataCollectorImportID(tc.id)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("parseDataCollectorImportID(%q) = %q, nil; want error", tc.id, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseDataCollectorImportID(%q) returned unexpected error: %v", tc.id, err)
+			}
+			if got != tc.want {
+				t.Fatalf("parseDataCollectorImportID(%q) = %q, want %q", tc.id, got, tc.want)
+			}
+		})
+	}
+}
+
+// dataCollectorTestState builds a tfsdk.State for the dc_collector schema
+// with every attribute null except name. That's enough to drive Read
+// without hand-rolling the timeouts block's attribute types.
+func dataCollectorTestState(t *testing.T, ctx context.Context, r *dataCollectorResource, name string) tfsdk.State {
+	t.Helper()
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	objType, ok := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	if !ok {
+		t.Fatalf("expected dc_collector schema type to be an object")
+	}
+
+	values := make(map[string]tftypes.Value, len(objType.AttributeTypes))
+	for attrName, attrType := range objType.AttributeTypes {
+		if attrName == "name" {
+			values[attrName] = tftypes.NewValue(attrType, name)
+			continue
+		}
+		values[attrName] = tftypes.NewValue(attrType, nil)
+	}
+
+	return tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(objType, values),
+	}
+}
+
+// TestDataCollectorResourceReadNotFound confirms that a 404 from the API
+// clears the resource from state (so Terraform plans a recreate) instead of
+// failing the apply with a diagnostic.
+func TestDataCollectorResourceReadNotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"error":{"code":404,"status":"NOT_FOUND","message":"DataCollector not found"}}`))
+	}))
+	defer server.Close()
+
+	apigeeClient := client.New(server.Client(), "test-org")
+	apigeeClient.BaseURL = server.URL
+
+	r := &dataCollectorResource{cfg: &frameworkConfig{apigee: apigeeClient}}
+	ctx := context.Background()
+
+	state := dataCollectorTestState(t, ctx, r, "my-collector")
+	req := resource.ReadRequest{State: state}
+	resp := &resource.ReadResponse{State: state}
+
+	r.Read(ctx, req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Read returned unexpected diagnostics on a 404: %v", resp.Diagnostics)
+	}
+	if !resp.State.Raw.IsNull() {
+		t.Fatalf("expected Read to remove state on a 404, got: %v", resp.State.Raw)
+	}
+}
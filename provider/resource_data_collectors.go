@@ -0,0 +1,301 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/moodys-ma-external/kyc-apigee/tf-provider/dc-apigee/apigee/client"
+)
+
+const defaultDataCollectorsParallelism = 10
+
+var (
+	_ resource.Resource              = &dataCollectorsResource{}
+	_ resource.ResourceWithConfigure = &dataCollectorsResource{}
+)
+
+// NewDataCollectorsResource returns a new instance of the plural
+// dc_collectors resource, which reconciles the set of data collectors
+// named by the provider's dc_names against the Apigee org in one apply.
+func NewDataCollectorsResource() resource.Resource {
+	return &dataCollectorsResource{}
+}
+
+type dataCollectorsResource struct {
+	cfg *frameworkConfig
+}
+
+type dataCollectorsModel struct {
+	Names       []types.String `tfsdk:"names"`
+	Parallelism types.Int64    `tfsdk:"parallelism"`
+}
+
+func (r *dataCollectorsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_collectors"
+}
+
+func (r *dataCollectorsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reconciles the data collectors named by the provider's dc_names against the Apigee org.",
+		Attributes: map[string]schema.Attribute{
+			"names": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "The data collector names currently reconciled, mirrors the provider's dc_names",
+			},
+			"parallelism": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(defaultDataCollectorsParallelism),
+				Description: "Number of data collectors to create/delete concurrently",
+				Validators: []validator.Int64{
+					int64AtLeastOne{},
+				},
+			},
+		},
+	}
+}
+
+func (r *dataCollectorsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	cfg, ok := req.ProviderData.(*frameworkConfig)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected resource configure type", fmt.Sprintf("expected *frameworkConfig, got: %T", req.ProviderData))
+		return
+	}
+	r.cfg = cfg
+}
+
+func (r *dataCollectorsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan dataCollectorsModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	failedCreates, diags := r.reconcile(ctx, plan.Parallelism.ValueInt64())
+	resp.Diagnostics.Append(diags...)
+	plan.Names = fromStringSlice(succeededNames(r.cfg.dcNames, failedCreates))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *dataCollectorsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state dataCollectorsModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	existing, err := r.cfg.apigee.DataCollectors.List(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list data collectors", err.Error())
+		return
+	}
+	present := make(map[string]bool, len(existing))
+	for _, dc := range existing {
+		present[dc.Name] = true
+	}
+
+	reconciled := make([]string, 0, len(r.cfg.dcNames))
+	for _, name := range r.cfg.dcNames {
+		if present[name] {
+			reconciled = append(reconciled, name)
+		}
+	}
+
+	state.Names = fromStringSlice(reconciled)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *dataCollectorsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan dataCollectorsModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	failedCreates, diags := r.reconcile(ctx, plan.Parallelism.ValueInt64())
+	resp.Diagnostics.Append(diags...)
+	plan.Names = fromStringSlice(succeededNames(r.cfg.dcNames, failedCreates))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *dataCollectorsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state dataCollectorsModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	parallelism := state.Parallelism.ValueInt64()
+	if parallelism <= 0 {
+		parallelism = defaultDataCollectorsParallelism
+	}
+
+	names := toStringSlice(state.Names)
+	indexOf := make(map[string]int, len(names))
+	for i, name := range names {
+		indexOf[name] = i
+	}
+
+	_, diags := r.forEach(ctx, int(parallelism), names, indexOf, func(ctx context.Context, name string) error {
+		_, err := r.cfg.apigee.DataCollectors.Delete(ctx, name)
+		return err
+	})
+	resp.Diagnostics.Append(diags...)
+}
+
+// succeededNames returns wanted minus failed, preserving wanted's order, so
+// Create/Update can record only the collectors reconcile actually confirmed
+// rather than claiming the full wanted list succeeded.
+func succeededNames(wanted, failed []string) []string {
+	failedSet := make(map[string]bool, len(failed))
+	for _, name := range failed {
+		failedSet[name] = true
+	}
+
+	out := make([]string, 0, len(wanted))
+	for _, name := range wanted {
+		if !failedSet[name] {
+			out = append(out, name)
+		}
+	}
+	return out
+}
+
+// reconcile brings the org's data collectors in line with the provider's
+// dc_names: one POST per name that's missing, and one DELETE per existing
+// collector that's no longer named, so shrinking dc_names on a later apply
+// doesn't leave orphans behind. Each side runs against a bounded worker pool
+// sized by parallelism, and a failure for one name doesn't stop the others
+// from being attempted. It returns the dc_names entries whose create failed,
+// so callers can keep state accurate even when reconcile partially fails.
+func (r *dataCollectorsResource) reconcile(ctx context.Context, parallelism int64) ([]string, diag.Diagnostics) {
+	if parallelism <= 0 {
+		parallelism = defaultDataCollectorsParallelism
+	}
+
+	existing, err := r.cfg.apigee.DataCollectors.List(ctx)
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError("Failed to list data collectors", err.Error())
+		return nil, diags
+	}
+	present := make(map[string]bool, len(existing))
+	for _, dc := range existing {
+		present[dc.Name] = true
+	}
+
+	wanted := make(map[string]bool, len(r.cfg.dcNames))
+	for _, name := range r.cfg.dcNames {
+		wanted[name] = true
+	}
+
+	missing := make([]string, 0)
+	for _, name := range r.cfg.dcNames {
+		if !present[name] {
+			missing = append(missing, name)
+		}
+	}
+
+	orphaned := make([]string, 0)
+	for _, dc := range existing {
+		if !wanted[dc.Name] {
+			orphaned = append(orphaned, dc.Name)
+		}
+	}
+
+	indexOf := make(map[string]int, len(r.cfg.dcNames))
+	for i, name := range r.cfg.dcNames {
+		indexOf[name] = i
+	}
+
+	failedCreates, diags := r.forEach(ctx, int(parallelism), missing, indexOf, func(ctx context.Context, name string) error {
+		_, _, err := r.cfg.apigee.DataCollectors.Create(ctx, &client.DataCollector{Name: name, Type: "datapoints"})
+		return err
+	})
+	// orphaned names aren't in dc_names, so they have no "names" list index
+	// to attach a diagnostic to; nil here falls back to a plain AddError.
+	_, deleteDiags := r.forEach(ctx, int(parallelism), orphaned, nil, func(ctx context.Context, name string) error {
+		_, err := r.cfg.apigee.DataCollectors.Delete(ctx, name)
+		return err
+	})
+	diags.Append(deleteDiags...)
+
+	return failedCreates, diags
+}
+
+// forEach runs fn over names using a worker pool of at most parallelism
+// goroutines, aggregating a diagnostic per failure. When indexOf contains
+// name, the diagnostic carries an AttributePath at "names"'s matching index
+// so partial failures are attributable without rolling back successful
+// items; otherwise it falls back to a plain, non-attribute-scoped error. It
+// also returns the names fn failed on, so callers can react beyond just
+// surfacing a diagnostic.
+func (r *dataCollectorsResource) forEach(ctx context.Context, parallelism int, names []string, indexOf map[string]int, fn func(context.Context, string) error) ([]string, diag.Diagnostics) {
+	var (
+		diags  diag.Diagnostics
+		failed []string
+		mu     sync.Mutex
+		wg     sync.WaitGroup
+	)
+
+	sem := make(chan struct{}, parallelism)
+
+	for _, name := range names {
+		name := name
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			if err := fn(ctx, name); err != nil {
+				mu.Lock()
+				failed = append(failed, name)
+				if idx, ok := indexOf[name]; ok {
+					diags.AddAttributeError(path.Root("names").AtListIndex(idx), "Failed to reconcile data collector", fmt.Sprintf("%s: %v", name, err))
+				} else {
+					diags.AddError("Failed to reconcile data collector", fmt.Sprintf("%s: %v", name, err))
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	return failed, diags
+}
+
+// int64AtLeastOne rejects a configured parallelism of zero or less.
+type int64AtLeastOne struct{}
+
+func (v int64AtLeastOne) Description(ctx context.Context) string {
+	return "value must be at least 1"
+}
+
+func (v int64AtLeastOne) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v int64AtLeastOne) ValidateInt64(ctx context.Context, req validator.Int64Request, resp *validator.Int64Response) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if req.ConfigValue.ValueInt64() < 1 {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid parallelism", "parallelism must be at least 1")
+	}
+}
@@ -0,0 +1,107 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/moodys-ma-external/kyc-apigee/tf-provider/dc-apigee/apigee/client"
+)
+
+// TestDataCollectorsReconcile drives reconcile against a fake Apigee API that
+// already has "existing" and "orphaned" collectors. dc_names wants
+// "existing" and "missing", plus "broken" whose create always fails. It
+// checks reconcile creates only what's missing, deletes only what's
+// orphaned, keeps going after the failed create, and reports "broken" back
+// to the caller instead of silently dropping it.
+func TestDataCollectorsReconcile(t *testing.T) {
+	var mu sync.Mutex
+	created := map[string]bool{}
+	deleted := map[string]bool{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/organizations/test-org/datacollectors":
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"dataCollectors": []client.DataCollector{
+					{Name: "existing", Type: "datapoints"},
+					{Name: "orphaned", Type: "datapoints"},
+				},
+			})
+		case req.Method == http.MethodPost && req.URL.Path == "/organizations/test-org/datacollectors":
+			var dc client.DataCollector
+			json.NewDecoder(req.Body).Decode(&dc)
+			if dc.Name == "broken" {
+				w.WriteHeader(http.StatusInternalServerError)
+				w.Write([]byte(`{"error":{"code":500,"status":"INTERNAL","message":"boom"}}`))
+				return
+			}
+			mu.Lock()
+			created[dc.Name] = true
+			mu.Unlock()
+			json.NewEncoder(w).Encode(dc)
+		case req.Method == http.MethodDelete && strings.HasPrefix(req.URL.Path, "/organizations/test-org/datacollectors/"):
+			name := strings.TrimPrefix(req.URL.Path, "/organizations/test-org/datacollectors/")
+			mu.Lock()
+			deleted[name] = true
+			mu.Unlock()
+			w.Write([]byte(`{}`))
+		default:
+			t.Fatalf("unexpected request %s %s", req.Method, req.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	apigeeClient := client.New(server.Client(), "test-org")
+	apigeeClient.BaseURL = server.URL
+
+	r := &dataCollectorsResource{cfg: &frameworkConfig{
+		apigee:  apigeeClient,
+		dcNames: []string{"existing", "missing", "broken"},
+	}}
+
+	failedCreates, diags := r.reconcile(context.Background(), 2)
+
+	if !diags.HasError() {
+		t.Fatalf("expected a diagnostic for the failed create, got none")
+	}
+	if len(failedCreates) != 1 || failedCreates[0] != "broken" {
+		t.Fatalf("failedCreates = %v, want [broken]", failedCreates)
+	}
+
+	if !created["missing"] {
+		t.Errorf("expected \"missing\" to be created")
+	}
+	if created["broken"] {
+		t.Errorf("did not expect \"broken\" to be recorded as created")
+	}
+	if created["existing"] {
+		t.Errorf("did not expect \"existing\" to be recreated, it already existed")
+	}
+	if !deleted["orphaned"] {
+		t.Errorf("expected \"orphaned\" to be deleted")
+	}
+	if deleted["existing"] || deleted["missing"] {
+		t.Errorf("did not expect a wanted name to be deleted")
+	}
+}
+
+// TestSucceededNames confirms Create/Update narrow their reconciled Names to
+// wanted minus whatever reconcile reported as failed.
+func TestSucceededNames(t *testing.T) {
+	got := succeededNames([]string{"a", "b", "c"}, []string{"b"})
+	want := []string{"a", "c"}
+
+	if len(got) != len(want) {
+		t.Fatalf("succeededNames() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("succeededNames() = %v, want %v", got, want)
+		}
+	}
+}
@@ -0,0 +1,203 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/moodys-ma-external/kyc-apigee/tf-provider/dc-apigee/apigee/client"
+)
+
+var (
+	_ resource.Resource                = &environmentGroupResource{}
+	_ resource.ResourceWithConfigure   = &environmentGroupResource{}
+	_ resource.ResourceWithImportState = &environmentGroupResource{}
+)
+
+// NewEnvironmentGroupResource returns a new instance of the dc_envgroup
+// framework resource.
+func NewEnvironmentGroupResource() resource.Resource {
+	return &environmentGroupResource{}
+}
+
+type environmentGroupResource struct {
+	cfg *frameworkConfig
+}
+
+type environmentGroupModel struct {
+	Name      types.String   `tfsdk:"name"`
+	Hostnames []types.String `tfsdk:"hostnames"`
+	Timeouts  timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *environmentGroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_envgroup"
+}
+
+func (r *environmentGroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"hostnames": schema.ListAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *environmentGroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	cfg, ok := req.ProviderData.(*frameworkConfig)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected resource configure type", fmt.Sprintf("expected *frameworkConfig, got: %T", req.ProviderData))
+		return
+	}
+	r.cfg = cfg
+}
+
+func toStringSlice(in []types.String) []string {
+	out := make([]string, 0, len(in))
+	for _, v := range in {
+		out = append(out, v.ValueString())
+	}
+	return out
+}
+
+func fromStringSlice(in []string) []types.String {
+	out := make([]types.String, 0, len(in))
+	for _, v := range in {
+		out = append(out, types.StringValue(v))
+	}
+	return out
+}
+
+func (r *environmentGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan environmentGroupModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, body, err := r.cfg.apigee.EnvironmentGroups.Create(ctx, &client.EnvironmentGroup{
+		Name:      plan.Name.ValueString(),
+		Hostnames: toStringSlice(plan.Hostnames),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create environment group", err.Error())
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := waitIfOperation(ctx, r.cfg, body, createTimeout, nil); err != nil {
+		resp.Diagnostics.AddError("Failed waiting for environment group creation", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *environmentGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state environmentGroupModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group, err := r.cfg.apigee.EnvironmentGroups.Get(ctx, state.Name.ValueString())
+	if err != nil {
+		if client.NotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Failed to read environment group", err.Error())
+		return
+	}
+
+	state.Name = types.StringValue(group.Name)
+	state.Hostnames = fromStringSlice(group.Hostnames)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *environmentGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan environmentGroupModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, body, err := r.cfg.apigee.EnvironmentGroups.Update(ctx, &client.EnvironmentGroup{
+		Name:      plan.Name.ValueString(),
+		Hostnames: toStringSlice(plan.Hostnames),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update environment group", err.Error())
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := waitIfOperation(ctx, r.cfg, body, updateTimeout, nil); err != nil {
+		resp.Diagnostics.AddError("Failed waiting for environment group update", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *environmentGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state environmentGroupModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, err := r.cfg.apigee.EnvironmentGroups.Delete(ctx, state.Name.ValueString())
+	if err != nil && !client.NotFound(err) {
+		resp.Diagnostics.AddError("Failed to delete environment group", err.Error())
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := waitIfOperation(ctx, r.cfg, body, deleteTimeout, nil); err != nil {
+		resp.Diagnostics.AddError("Failed waiting for environment group deletion", err.Error())
+		return
+	}
+}
+
+func (r *environmentGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
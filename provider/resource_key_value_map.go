@@ -0,0 +1,165 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/moodys-ma-external/kyc-apigee/tf-provider/dc-apigee/apigee/client"
+)
+
+var (
+	_ resource.Resource                = &keyValueMapResource{}
+	_ resource.ResourceWithConfigure   = &keyValueMapResource{}
+	_ resource.ResourceWithImportState = &keyValueMapResource{}
+)
+
+// NewKeyValueMapResource returns a new instance of the dc_kvm framework
+// resource.
+func NewKeyValueMapResource() resource.Resource {
+	return &keyValueMapResource{}
+}
+
+type keyValueMapResource struct {
+	cfg *frameworkConfig
+}
+
+type keyValueMapEntryModel struct {
+	Name  types.String `tfsdk:"name"`
+	Value types.String `tfsdk:"value"`
+}
+
+type keyValueMapModel struct {
+	Name    types.String            `tfsdk:"name"`
+	Entries []keyValueMapEntryModel `tfsdk:"entries"`
+}
+
+func (r *keyValueMapResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kvm"
+}
+
+func (r *keyValueMapResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"entries": schema.ListNestedAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Required: true,
+						},
+						"value": schema.StringAttribute{
+							Required:  true,
+							Sensitive: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *keyValueMapResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	cfg, ok := req.ProviderData.(*frameworkConfig)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected resource configure type", fmt.Sprintf("expected *frameworkConfig, got: %T", req.ProviderData))
+		return
+	}
+	r.cfg = cfg
+}
+
+func (m keyValueMapModel) toClientEntries() []client.KeyValueMapEntry {
+	entries := make([]client.KeyValueMapEntry, 0, len(m.Entries))
+	for _, e := range m.Entries {
+		entries = append(entries, client.KeyValueMapEntry{Name: e.Name.ValueString(), Value: e.Value.ValueString()})
+	}
+	return entries
+}
+
+func (r *keyValueMapResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan keyValueMapModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.cfg.apigee.KeyValueMaps.Create(ctx, &client.KeyValueMap{
+		Name:    plan.Name.ValueString(),
+		Entries: plan.toClientEntries(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create key value map", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *keyValueMapResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state keyValueMapModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.cfg.apigee.KeyValueMaps.Get(ctx, state.Name.ValueString())
+	if err != nil {
+		if client.NotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Failed to read key value map", err.Error())
+		return
+	}
+
+	// Apigee never returns entry values on read, so state is left as-is
+	// beyond confirming the map still exists.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update should be unreachable: name and entries are the only attributes in
+// this schema and both carry a RequiresReplace plan modifier, so Terraform
+// replaces the resource instead of planning an in-place update. It's kept as
+// a defensive backstop rather than a panic.
+func (r *keyValueMapResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.AddError(
+		"Key value maps cannot be updated in place",
+		"dc_kvm entries are immutable once created; change the name or entries to force a replacement",
+	)
+}
+
+func (r *keyValueMapResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state keyValueMapModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.cfg.apigee.KeyValueMaps.Delete(ctx, state.Name.ValueString()); err != nil && !client.NotFound(err) {
+		resp.Diagnostics.AddError("Failed to delete key value map", err.Error())
+		return
+	}
+}
+
+func (r *keyValueMapResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
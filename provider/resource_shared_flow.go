@@ -0,0 +1,133 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/moodys-ma-external/kyc-apigee/tf-provider/dc-apigee/apigee/client"
+)
+
+var (
+	_ resource.Resource                = &sharedFlowResource{}
+	_ resource.ResourceWithConfigure   = &sharedFlowResource{}
+	_ resource.ResourceWithImportState = &sharedFlowResource{}
+)
+
+// NewSharedFlowResource returns a new instance of the dc_sharedflow
+// framework resource. It tracks the lifecycle of an existing shared flow
+// bundle; deploying new bundle revisions is out of scope.
+func NewSharedFlowResource() resource.Resource {
+	return &sharedFlowResource{}
+}
+
+type sharedFlowResource struct {
+	cfg *frameworkConfig
+}
+
+type sharedFlowModel struct {
+	Name           types.String `tfsdk:"name"`
+	LatestRevision types.String `tfsdk:"latest_revision"`
+}
+
+func (r *sharedFlowResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sharedflow"
+}
+
+func (r *sharedFlowResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required: true,
+			},
+			"latest_revision": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (r *sharedFlowResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	cfg, ok := req.ProviderData.(*frameworkConfig)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected resource configure type", fmt.Sprintf("expected *frameworkConfig, got: %T", req.ProviderData))
+		return
+	}
+	r.cfg = cfg
+}
+
+func (r *sharedFlowResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan sharedFlowModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sf, err := r.cfg.apigee.SharedFlows.Get(ctx, plan.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to look up shared flow", err.Error())
+		return
+	}
+
+	plan.LatestRevision = types.StringValue(sf.LatestRevision)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *sharedFlowResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state sharedFlowModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sf, err := r.cfg.apigee.SharedFlows.Get(ctx, state.Name.ValueString())
+	if err != nil {
+		if client.NotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Failed to read shared flow", err.Error())
+		return
+	}
+
+	state.Name = types.StringValue(sf.Name)
+	state.LatestRevision = types.StringValue(sf.LatestRevision)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *sharedFlowResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan sharedFlowModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sf, err := r.cfg.apigee.SharedFlows.Get(ctx, plan.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to look up shared flow", err.Error())
+		return
+	}
+
+	plan.LatestRevision = types.StringValue(sf.LatestRevision)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete intentionally does nothing to the real shared flow. dc_sharedflow
+// only adopts an existing bundle (see Create); since this resource never
+// provisioned it, removing the resource from configuration or running
+// terraform destroy must not delete it out from under whatever process
+// actually owns its lifecycle.
+func (r *sharedFlowResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
+
+func (r *sharedFlowResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
@@ -0,0 +1,158 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/moodys-ma-external/kyc-apigee/tf-provider/dc-apigee/apigee/client"
+)
+
+var (
+	_ resource.Resource              = &targetServerResource{}
+	_ resource.ResourceWithConfigure = &targetServerResource{}
+)
+
+// NewTargetServerResource returns a new instance of the dc_target_server
+// framework resource.
+func NewTargetServerResource() resource.Resource {
+	return &targetServerResource{}
+}
+
+type targetServerResource struct {
+	cfg *frameworkConfig
+}
+
+type targetServerModel struct {
+	Environment types.String `tfsdk:"environment"`
+	Name        types.String `tfsdk:"name"`
+	Host        types.String `tfsdk:"host"`
+	Port        types.Int64  `tfsdk:"port"`
+	IsEnabled   types.Bool   `tfsdk:"is_enabled"`
+}
+
+func (r *targetServerResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_target_server"
+}
+
+func (r *targetServerResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"environment": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"host": schema.StringAttribute{
+				Required: true,
+			},
+			"port": schema.Int64Attribute{
+				Required: true,
+			},
+			"is_enabled": schema.BoolAttribute{
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (r *targetServerResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	cfg, ok := req.ProviderData.(*frameworkConfig)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected resource configure type", fmt.Sprintf("expected *frameworkConfig, got: %T", req.ProviderData))
+		return
+	}
+	r.cfg = cfg
+}
+
+func (m targetServerModel) toClient() *client.TargetServer {
+	return &client.TargetServer{
+		Name:      m.Name.ValueString(),
+		Host:      m.Host.ValueString(),
+		Port:      int(m.Port.ValueInt64()),
+		IsEnabled: m.IsEnabled.ValueBool(),
+	}
+}
+
+func (r *targetServerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan targetServerModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if _, err := r.cfg.apigee.TargetServers.Create(ctx, plan.Environment.ValueString(), plan.toClient()); err != nil {
+		resp.Diagnostics.AddError("Failed to create target server", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *targetServerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state targetServerModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ts, err := r.cfg.apigee.TargetServers.Get(ctx, state.Environment.ValueString(), state.Name.ValueString())
+	if err != nil {
+		if client.NotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Failed to read target server", err.Error())
+		return
+	}
+
+	state.Name = types.StringValue(ts.Name)
+	state.Host = types.StringValue(ts.Host)
+	state.Port = types.Int64Value(int64(ts.Port))
+	state.IsEnabled = types.BoolValue(ts.IsEnabled)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *targetServerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan targetServerModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if _, err := r.cfg.apigee.TargetServers.Update(ctx, plan.Environment.ValueString(), plan.toClient()); err != nil {
+		resp.Diagnostics.AddError("Failed to update target server", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *targetServerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state targetServerModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.cfg.apigee.TargetServers.Delete(ctx, state.Environment.ValueString(), state.Name.ValueString()); err != nil && !client.NotFound(err) {
+		resp.Diagnostics.AddError("Failed to delete target server", err.Error())
+		return
+	}
+}